@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/automato-io/binocs-client-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceChannel() *schema.Resource {
+	return &schema.Resource{
+		Description: "`binocs_channel` data source looks up an existing notification channel by `handle` or `alias`.",
+
+		Read: dataSourceChannelRead,
+
+		Schema: map[string]*schema.Schema{
+			"handle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The channel handle to look up. Either `handle` or `alias` is required.",
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The channel alias to look up. Either `handle` or `alias` is required.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The channel type.",
+			},
+			"checks": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The checks associated with this notification channel.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceChannelRead(d *schema.ResourceData, meta interface{}) error {
+	rc := meta.(*retryingClient)
+	handle, hasHandle := d.GetOk("handle")
+	alias, hasAlias := d.GetOk("alias")
+	if !hasHandle && !hasAlias {
+		return fmt.Errorf("either \"handle\" or \"alias\" must be set")
+	}
+
+	var channels []binocs.Channel
+	err := rc.Do(func() error {
+		var err error
+		channels, err = rc.raw.Channels.List()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list Binocs channels: %s", err)
+	}
+
+	var found *binocs.Channel
+	for i := range channels {
+		if hasHandle && channels[i].Handle == handle.(string) {
+			found = &channels[i]
+			break
+		}
+		if hasAlias && channels[i].Alias == alias.(string) {
+			found = &channels[i]
+			break
+		}
+	}
+	if found == nil {
+		return fmt.Errorf("no Binocs channel found matching the given handle or alias")
+	}
+
+	d.SetId(found.Ident)
+	for k, v := range map[string]interface{}{
+		"handle": found.Handle,
+		"alias":  found.Alias,
+		"type":   found.Type,
+		"checks": found.Checks,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/automato-io/binocs-client-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCheck() *schema.Resource {
+	return &schema.Resource{
+		Description: "`binocs_check` data source looks up an existing check by `name` or `resource`.",
+
+		Read: dataSourceCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name (alias) of the check to look up. Either `name` or `resource` is required.",
+			},
+			"resource": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The resource URL of the check to look up. Either `name` or `resource` is required.",
+			},
+			"method": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The HTTP method. Only set for HTTP(S) resources.",
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How often Binocs checks this resource, in seconds.",
+			},
+			"target": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The response time that accommodates Apdex=1.0, in seconds.",
+			},
+			"regions": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "From where in the world Binocs checks this resource.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"up_codes": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The good (\"up\") HTTP(S) response codes.",
+			},
+			"up_confirmations_threshold": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How many subsequent \"up\" responses need to occur before Binocs creates an incident.",
+			},
+			"down_confirmations_threshold": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How many subsequent \"down\" responses need to occur before Binocs closes an incident.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of this check, e.g. \"up\", \"down\", or \"degraded\".",
+			},
+			"apdex": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The current Apdex score of this check.",
+			},
+			"uptime": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The current uptime ratio of this check, between 0.0 and 1.0.",
+			},
+		},
+	}
+}
+
+func dataSourceCheckRead(d *schema.ResourceData, meta interface{}) error {
+	rc := meta.(*retryingClient)
+	name, hasName := d.GetOk("name")
+	resource, hasResource := d.GetOk("resource")
+	if !hasName && !hasResource {
+		return fmt.Errorf("either \"name\" or \"resource\" must be set")
+	}
+
+	var checks []binocs.Check
+	err := rc.Do(func() error {
+		var err error
+		checks, err = rc.raw.Checks.List()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list Binocs checks: %s", err)
+	}
+
+	var found *binocs.Check
+	for i := range checks {
+		if hasName && checks[i].Name == name.(string) {
+			found = &checks[i]
+			break
+		}
+		if hasResource && checks[i].Resource == resource.(string) {
+			found = &checks[i]
+			break
+		}
+	}
+	if found == nil {
+		return fmt.Errorf("no Binocs check found matching the given name or resource")
+	}
+
+	d.SetId(found.Ident)
+	for k, v := range map[string]interface{}{
+		"name":                         found.Name,
+		"resource":                     found.Resource,
+		"method":                       found.Method,
+		"interval":                     found.Interval,
+		"target":                       found.Target,
+		"regions":                      found.Regions,
+		"up_codes":                     found.UpCodes,
+		"up_confirmations_threshold":   found.UpConfirmationsThreshold,
+		"down_confirmations_threshold": found.DownConfirmationsThreshold,
+		"status":                       found.Status,
+		"apdex":                        found.Apdex,
+		"uptime":                       found.Uptime,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/automato-io/binocs-client-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIncidents() *schema.Resource {
+	return &schema.Resource{
+		Description: "`binocs_incidents` data source returns incidents matching the given filters.",
+
+		Read: dataSourceIncidentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return incidents for this check id.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return incidents in this state, e.g. \"open\" or \"closed\".",
+			},
+			"opened_after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return incidents opened after this RFC3339 timestamp.",
+			},
+			"closed_before": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return incidents closed before this RFC3339 timestamp.",
+			},
+			"incidents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The incidents matching the given filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opened_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"closed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIncidentsRead(d *schema.ResourceData, meta interface{}) error {
+	rc := meta.(*retryingClient)
+	filter := binocs.IncidentFilter{}
+
+	if v, ok := d.GetOk("check_id"); ok {
+		filter.CheckID = v.(string)
+	}
+	if v, ok := d.GetOk("state"); ok {
+		filter.State = v.(string)
+	}
+	if v, ok := d.GetOk("opened_after"); ok {
+		filter.OpenedAfter = v.(string)
+	}
+	if v, ok := d.GetOk("closed_before"); ok {
+		filter.ClosedBefore = v.(string)
+	}
+
+	var incidents []binocs.Incident
+	err := rc.Do(func() error {
+		var err error
+		incidents, err = rc.raw.Incidents.List(filter)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list Binocs incidents: %s", err)
+	}
+
+	result := make([]interface{}, 0, len(incidents))
+	for _, incident := range incidents {
+		result = append(result, map[string]interface{}{
+			"id":        incident.Ident,
+			"check_id":  incident.CheckID,
+			"state":     incident.State,
+			"opened_at": incident.OpenedAt,
+			"closed_at": incident.ClosedAt,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s-%s-%s", filter.CheckID, filter.State, filter.OpenedAfter, filter.ClosedBefore))
+	if err := d.Set("incidents", result); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRegions() *schema.Resource {
+	return &schema.Resource{
+		Description: "`binocs_regions` data source returns the list of regions currently supported by the Binocs API.",
+
+		Read: dataSourceRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of currently-supported region names.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRegionsRead(d *schema.ResourceData, meta interface{}) error {
+	rc := meta.(*retryingClient)
+	var regions []string
+	err := rc.Do(func() error {
+		var err error
+		regions, err = rc.raw.Regions.List()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list Binocs regions: %s", err)
+	}
+	d.SetId("binocs_regions")
+	if err := d.Set("names", regions); err != nil {
+		return err
+	}
+	return nil
+}
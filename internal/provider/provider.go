@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"time"
+
 	"github.com/automato-io/binocs-client-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -22,11 +24,42 @@ func New() func() *schema.Provider {
 					DefaultFunc: schema.EnvDefaultFunc("BINOCS_SECRET_KEY", ""),
 					Description: "Secret Key required to communicate with Binocs API. Get yours at [https://binocs.sh](https://binocs.sh)",
 				},
+				"max_retries": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("BINOCS_MAX_RETRIES", defaultMaxRetries),
+					Description: "How many times to retry a Binocs API call that fails with a transient error (network failure, 5xx, or 429) before giving up.",
+				},
+				"min_backoff": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("BINOCS_MIN_BACKOFF", int(defaultMinBackoff/time.Millisecond)),
+					Description: "The initial backoff between retries, in milliseconds. Doubles after each retry up to `max_backoff`.",
+				},
+				"max_backoff": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("BINOCS_MAX_BACKOFF", int(defaultMaxBackoff/time.Millisecond)),
+					Description: "The maximum backoff between retries, in milliseconds.",
+				},
+				"request_timeout": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("BINOCS_REQUEST_TIMEOUT", int(defaultRequestTimeout/time.Millisecond)),
+					Description: "The timeout for a single Binocs API call, in milliseconds.",
+				},
 			},
 			ConfigureFunc: configureProvider,
 			ResourcesMap: map[string]*schema.Resource{
-				"binocs_check":   checkResource(),
-				"binocs_channel": channelResource(),
+				"binocs_check":             checkResource(),
+				"binocs_channel":           channelResource(),
+				"binocs_notification_rule": notificationRuleResource(),
+			},
+			DataSourcesMap: map[string]*schema.Resource{
+				"binocs_check":     dataSourceCheck(),
+				"binocs_channel":   dataSourceChannel(),
+				"binocs_regions":   dataSourceRegions(),
+				"binocs_incidents": dataSourceIncidents(),
 			},
 		}
 	}
@@ -37,9 +70,17 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 		AccessKey: d.Get("access_key").(string),
 		SecretKey: d.Get("secret_key").(string),
 	}
-	binocs, err := binocs.New(config)
+	raw, err := binocs.New(config)
 	if err != nil {
 		return nil, err
 	}
-	return binocs, nil
+
+	retry := retryConfig{
+		MaxRetries:     d.Get("max_retries").(int),
+		MinBackoff:     time.Duration(d.Get("min_backoff").(int)) * time.Millisecond,
+		MaxBackoff:     time.Duration(d.Get("max_backoff").(int)) * time.Millisecond,
+		RequestTimeout: time.Duration(d.Get("request_timeout").(int)) * time.Millisecond,
+	}
+
+	return newRetryingClient(raw, retry), nil
 }
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -10,22 +12,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	channelTypeEmail    = "email"
+	channelTypeSlack    = "slack"
+	channelTypeTelegram = "telegram"
+	channelTypeWebhook  = "webhook"
+)
+
 var supportedChannelTypes = []string{
-	"email",
+	channelTypeEmail,
+	channelTypeSlack,
+	channelTypeTelegram,
+	channelTypeWebhook,
 }
 
-var unsupportedChannelTypes = []string{
-	"telegram",
-	"slack",
-}
+var supportedWebhookMethods = []string{"GET", "POST", "PUT"}
 
+// validHandlePattern and validHandleDescription cover only the channel types
+// that use "handle" (channelCustomizeDiff rejects "handle" outright for
+// type = webhook, which is configured entirely via the "webhook" block).
 var validHandlePattern = map[string]string{
-	"email": `^(?:[a-z0-9!#$%&'*+/=?^_{|}~-]+(?:\.[a-z0-9!#$%&'*+/=?^_{|}~-]+)*|"(?:[\x01-\x08\x0b\x0c\x0e-\x1f\x21\x23-\x5b\x5d-\x7f]|\\[\x01-\x09\x0b\x0c\x0e-\x7f])*")@(?:(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z0-9](?:[a-z0-9-]*[a-z0-9])?|\[(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?|[a-z0-9-]*[a-z0-9]:(?:[\x01-\x08\x0b\x0c\x0e-\x1f\x21-\x5a\x53-\x7f]|\\[\x01-\x09\x0b\x0c\x0e-\x7f])+)\])$`,
+	channelTypeEmail:    `^(?:[a-z0-9!#$%&'*+/=?^_{|}~-]+(?:\.[a-z0-9!#$%&'*+/=?^_{|}~-]+)*|"(?:[\x01-\x08\x0b\x0c\x0e-\x1f\x21\x23-\x5b\x5d-\x7f]|\\[\x01-\x09\x0b\x0c\x0e-\x7f])*")@(?:(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z0-9](?:[a-z0-9-]*[a-z0-9])?|\[(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?|[a-z0-9-]*[a-z0-9]:(?:[\x01-\x08\x0b\x0c\x0e-\x1f\x21-\x5a\x53-\x7f]|\\[\x01-\x09\x0b\x0c\x0e-\x7f])+)\])$`,
+	channelTypeSlack:    `^https://hooks\.slack\.com/services/[A-Za-z0-9]+/[A-Za-z0-9]+/[A-Za-z0-9]+$`,
+	channelTypeTelegram: `^@?[A-Za-z0-9_-]+$`,
+}
+
+var validHandleDescription = map[string]string{
+	channelTypeEmail:    "a valid e-mail address",
+	channelTypeSlack:    "a valid Slack incoming webhook URL (https://hooks.slack.com/services/...)",
+	channelTypeTelegram: "a valid Telegram chat id or @channel handle",
 }
 
 func channelResource() *schema.Resource {
 	return &schema.Resource{
-		Description: "`binocs_channel` defines a notification channel",
+		Description: fmt.Sprintf("`binocs_channel` defines a notification channel. Supported channel types are %s.", strings.Join(supportedChannelTypes, ", ")),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -37,20 +57,21 @@ func channelResource() *schema.Resource {
 		Update: channelUpdate,
 		Delete: channelDelete,
 
+		CustomizeDiff: channelCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				Description:  fmt.Sprintf("The only supported channel is currently \"email\", and it requires e-mail address verification. All other notification channels (%s) currently require interactive creation using Binocs CLI. All notification channels can be imported to Terraform.", strings.Join(unsupportedChannelTypes, ", ")),
+				Description:  fmt.Sprintf("The channel type, one of %s. `email` requires e-mail address verification. All notification channels can be imported to Terraform.", strings.Join(supportedChannelTypes, ", ")),
 				ValidateFunc: validation.StringInSlice(supportedChannelTypes, false),
 			},
 			"handle": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				Description:  "The e-mail address for a channel of `type = email`.",
-				ValidateFunc: validation.StringMatch(regexp.MustCompile(validHandlePattern["email"]), "expected a valid e-mail address"),
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The channel handle: an e-mail address for `type = email`, a Slack incoming webhook URL for `type = slack`, a chat id or `@channel` handle for `type = telegram`. Not used with `type = webhook`, which is configured entirely via the `webhook` block.",
 			},
 			"alias": {
 				Type:         schema.TypeString,
@@ -69,17 +90,125 @@ func channelResource() *schema.Resource {
 				},
 				Set: schema.HashString,
 			},
+			"webhook": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Generic webhook configuration. Required when `type = webhook`, and not used otherwise.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The HTTPS URL Binocs sends the notification to.",
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "POST",
+							Description:  fmt.Sprintf("The HTTP method used to deliver the notification (one of %s).", strings.Join(supportedWebhookMethods, ", ")),
+							ValidateFunc: validation.StringInSlice(supportedWebhookMethods, false),
+						},
+						"headers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Additional HTTP headers sent with the webhook request.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"body_template": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A template used to render the webhook request body. When omitted, Binocs sends its default JSON payload.",
+						},
+						"secret": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "A shared secret used to sign the webhook request (e.g. via an `X-Binocs-Signature` header).",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// channelCustomizeDiff validates the "handle" and "webhook" fields against the
+// channel "type", since cross-field validation isn't available via ValidateFunc.
+func channelCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	channelType := d.Get("type").(string)
+	handle := d.Get("handle").(string)
+	_, hasWebhook := d.GetOk("webhook")
+
+	if channelType == channelTypeWebhook {
+		if !hasWebhook {
+			return fmt.Errorf("expected a \"webhook\" block to be set for a %s channel", channelTypeWebhook)
+		}
+		if handle != "" {
+			return fmt.Errorf("\"handle\" cannot be used with a %s channel; configure the \"webhook\" block instead", channelTypeWebhook)
+		}
+	} else {
+		if hasWebhook {
+			return fmt.Errorf("\"webhook\" can only be used with a %s channel", channelTypeWebhook)
+		}
+		if handle == "" {
+			return fmt.Errorf("expected \"handle\" to be set for a %s channel", channelType)
+		}
+		if pattern, ok := validHandlePattern[channelType]; ok {
+			if !regexp.MustCompile(pattern).MatchString(handle) {
+				return fmt.Errorf("expected \"handle\" to be %s", validHandleDescription[channelType])
+			}
+		}
+	}
+
+	return channelMarkChecksComputedIfRuleBound(d, meta)
+}
+
+// channelMarkChecksComputedIfRuleBound forces "checks" to computed for this
+// diff when one or more binocs_notification_rule resources already bind
+// checks to this channel, so the channelUpdate attach/detach reconciliation
+// doesn't fight the notification rule API over the same associations. It
+// only calls out to the API for an existing channel whose planned "checks"
+// set is non-empty (whether from configuration or carried over from state).
+func channelMarkChecksComputedIfRuleBound(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if v, ok := d.GetOk("checks"); !ok || v.(*schema.Set).Len() == 0 {
+		return nil
+	}
+	rc, ok := meta.(*retryingClient)
+	if !ok {
+		return nil
+	}
+	var rules []binocs.NotificationRule
+	err := rc.Do(func() error {
+		var err error
+		rules, err = rc.raw.NotificationRules.List(binocs.NotificationRuleFilter{ChannelID: d.Id()})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to check Binocs notification rules for channel %q: %w", d.Id(), err)
+	}
+	if len(rules) > 0 {
+		return d.SetNewComputed("checks")
+	}
+	return nil
+}
+
 func channelCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*binocs.Client)
+	rc := meta.(*retryingClient)
 	payload, err := constructChannelPayload(d)
 	if err != nil {
 		return err
 	}
-	channel, err := client.Channels.Create(payload)
+	var channel binocs.Channel
+	err = rc.DoOnce(func() error {
+		var err error
+		channel, err = rc.raw.Channels.Create(payload)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("unable to create Binocs channel: %s", err)
 	}
@@ -88,9 +217,12 @@ func channelCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("checks"); ok {
 		checksSlice := v.(*schema.Set).List()
 		for s := range checksSlice {
-			err = client.Channels.Attach(channel.Ident, checksSlice[s].(string))
+			checkID := checksSlice[s].(string)
+			err = rc.DoOnce(func() error {
+				return rc.raw.Channels.Attach(channel.Ident, checkID)
+			})
 			if err != nil {
-				return fmt.Errorf("unable to attach Binocs channel %q to check %q: %s", channel.Ident, checksSlice[s].(string), err)
+				return fmt.Errorf("unable to attach Binocs channel %q to check %q: %s", channel.Ident, checkID, err)
 			}
 		}
 	}
@@ -99,10 +231,15 @@ func channelCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func channelRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*binocs.Client)
-	channel, err := client.Channels.Read(d.Id())
+	rc := meta.(*retryingClient)
+	var channel binocs.Channel
+	err := rc.Do(func() error {
+		var err error
+		channel, err = rc.raw.Channels.Read(d.Id())
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("unable to read Binocs channel: %s", err)
+		return fmt.Errorf("unable to read Binocs channel: %w", err)
 	}
 	for k, v := range map[string]interface{}{
 		"type":   channel.Type,
@@ -114,12 +251,17 @@ func channelRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	if channel.Type == channelTypeWebhook {
+		if err := d.Set("webhook", flattenChannelWebhook(channel.Webhook)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func channelExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	err := channelRead(d, meta)
-	if err != nil && strings.Contains(err.Error(), "404") {
+	if err != nil && errors.Is(err, binocs.ErrNotFound) {
 		d.SetId("")
 		return false, nil
 	}
@@ -127,12 +269,14 @@ func channelExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 }
 
 func channelUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*binocs.Client)
+	rc := meta.(*retryingClient)
 	payload, err := constructChannelPayload(d)
 	if err != nil {
 		return err
 	}
-	err = client.Channels.Update(d.Id(), payload)
+	err = rc.Do(func() error {
+		return rc.raw.Channels.Update(d.Id(), payload)
+	})
 	if err != nil {
 		return fmt.Errorf("unable to update Binocs channel: %s", err)
 	}
@@ -150,17 +294,23 @@ func channelUpdate(d *schema.ResourceData, meta interface{}) error {
 		attach := ns.Difference(os).List()
 		if len(detach) > 0 {
 			for _, r := range detach {
-				err = client.Channels.Detach(d.Id(), r.(string))
+				checkID := r.(string)
+				err = rc.DoOnce(func() error {
+					return rc.raw.Channels.Detach(d.Id(), checkID)
+				})
 				if err != nil {
-					return fmt.Errorf("unable to detach Binocs channel %q from check %q: %s", d.Id(), r.(string), err)
+					return fmt.Errorf("unable to detach Binocs channel %q from check %q: %s", d.Id(), checkID, err)
 				}
 			}
 		}
 		if len(attach) > 0 {
 			for _, a := range attach {
-				err = client.Channels.Attach(d.Id(), a.(string))
+				checkID := a.(string)
+				err = rc.DoOnce(func() error {
+					return rc.raw.Channels.Attach(d.Id(), checkID)
+				})
 				if err != nil {
-					return fmt.Errorf("unable to attach Binocs channel %q to check %q: %s", d.Id(), a.(string), err)
+					return fmt.Errorf("unable to attach Binocs channel %q to check %q: %s", d.Id(), checkID, err)
 				}
 			}
 		}
@@ -169,8 +319,10 @@ func channelUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func channelDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*binocs.Client)
-	err := client.Channels.Delete(d.Id())
+	rc := meta.(*retryingClient)
+	err := rc.Do(func() error {
+		return rc.raw.Channels.Delete(d.Id())
+	})
 	if err != nil {
 		return fmt.Errorf("unable to remove Binocs channel: %s", err)
 	}
@@ -192,5 +344,47 @@ func constructChannelPayload(d *schema.ResourceData) (binocs.Channel, error) {
 		payload.Alias = v.(string)
 	}
 
+	if payload.Type == channelTypeWebhook {
+		if v, ok := d.GetOk("webhook"); ok {
+			payload.Webhook = expandChannelWebhook(v.([]interface{}))
+		}
+	}
+
 	return payload, nil
 }
+
+func expandChannelWebhook(l []interface{}) *binocs.ChannelWebhook {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+	webhook := &binocs.ChannelWebhook{
+		URL:          m["url"].(string),
+		Method:       m["method"].(string),
+		BodyTemplate: m["body_template"].(string),
+		Secret:       m["secret"].(string),
+	}
+	if v, ok := m["headers"].(map[string]interface{}); ok {
+		headers := make(map[string]string, len(v))
+		for k, hv := range v {
+			headers[k] = hv.(string)
+		}
+		webhook.Headers = headers
+	}
+	return webhook
+}
+
+func flattenChannelWebhook(webhook *binocs.ChannelWebhook) []interface{} {
+	if webhook == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"url":           webhook.URL,
+			"method":        webhook.Method,
+			"headers":       webhook.Headers,
+			"body_template": webhook.BodyTemplate,
+			"secret":        webhook.Secret,
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net"
 	"regexp"
@@ -39,6 +41,10 @@ var supportedRegions = []string{
 
 var supportedHTTPMethods = []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
 
+var supportedTLSVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+
+var methodsWithoutBody = []string{"GET", "HEAD"}
+
 func checkResource() *schema.Resource {
 	return &schema.Resource{
 		Description: "`binocs_check` defines a check",
@@ -53,6 +59,8 @@ func checkResource() *schema.Resource {
 		Update: checkUpdate,
 		Delete: checkDelete,
 
+		CustomizeDiff: checkCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -162,17 +170,145 @@ func checkResource() *schema.Resource {
 				Default:      2,
 				ValidateFunc: validation.IntBetween(1, 10),
 			},
+			"http": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "HTTP(S) request customization. Only used and allowed with HTTP(S) resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request_headers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Additional HTTP headers to send with the request.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"request_body": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The request body to send. Cannot be used with the GET or HEAD method.",
+						},
+						"basic_auth": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "HTTP basic authentication credentials.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"username": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"password": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+						"follow_redirects": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether Binocs follows HTTP redirects when checking this resource.",
+						},
+						"tls": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "TLS options. Only used and allowed with an HTTPS resource.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"skip_verify": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Whether to skip verification of the server's certificate chain and host name.",
+									},
+									"min_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Description:  fmt.Sprintf("The minimum accepted TLS version (one of %s).", strings.Join(supportedTLSVersions, ", ")),
+										ValidateFunc: validation.StringInSlice(supportedTLSVersions, false),
+									},
+									"expected_fingerprint": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The expected SHA-256 fingerprint of the server's leaf certificate.",
+									},
+								},
+							},
+						},
+						"expected_body_regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "A regular expression the response body must match. Only used and allowed with HTTP(S) resources.",
+							ValidateFunc: validateRegexPattern,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// checkCustomizeDiff validates "http" block fields that depend on other
+// top-level attributes, since cross-field validation isn't available via
+// ValidateFunc.
+func checkCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	protocol := strings.ToUpper(strings.Split(d.Get("resource").(string), ":")[0])
+	_, hasHTTP := d.GetOk("http")
+
+	if protocol != protocolHTTP && protocol != protocolHTTPS {
+		if hasHTTP {
+			return fmt.Errorf("\"http\" cannot be used with a %s resource", protocol)
+		}
+		return nil
+	}
+
+	if !hasHTTP {
+		return nil
+	}
+
+	method := d.Get("method").(string)
+	if v, ok := d.GetOk("http.0.request_body"); ok && v.(string) != "" && stringInSlice(method, methodsWithoutBody) {
+		return fmt.Errorf("\"http.request_body\" cannot be used with the %s method", method)
+	}
+
+	if protocol != protocolHTTPS {
+		if _, ok := d.GetOk("http.0.tls"); ok {
+			return fmt.Errorf("\"http.tls\" cannot be used with a %s resource", protocol)
+		}
+	}
+
+	return nil
+}
+
+func validateRegexPattern(i interface{}, s string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", s))
+		return
+	}
+	if _, err := regexp.Compile(v); err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a valid regular expression: %s", s, err))
+	}
+	return
+}
+
 func checkCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*binocs.Client)
+	rc := meta.(*retryingClient)
 	payload, err := constructCheckPayload(d)
 	if err != nil {
 		return err
 	}
-	check, err := client.Checks.Create(payload)
+	var check binocs.Check
+	err = rc.DoOnce(func() error {
+		var err error
+		check, err = rc.raw.Checks.Create(payload)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("unable to create Binocs check: %s", err)
 	}
@@ -181,10 +317,15 @@ func checkCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func checkRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*binocs.Client)
-	check, err := client.Checks.Read(d.Id())
+	rc := meta.(*retryingClient)
+	var check binocs.Check
+	err := rc.Do(func() error {
+		var err error
+		check, err = rc.raw.Checks.Read(d.Id())
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("unable to read Binocs check: %s", err)
+		return fmt.Errorf("unable to read Binocs check: %w", err)
 	}
 	for k, v := range map[string]interface{}{
 		"name":                         check.Name,
@@ -201,12 +342,18 @@ func checkRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	protocol := strings.ToUpper(strings.Split(check.Resource, ":")[0])
+	if protocol == protocolHTTP || protocol == protocolHTTPS {
+		if err := d.Set("http", flattenCheckHTTP(check.HTTP)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func checkExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	err := checkRead(d, meta)
-	if err != nil && strings.Contains(err.Error(), "404") {
+	if err != nil && errors.Is(err, binocs.ErrNotFound) {
 		d.SetId("")
 		return false, nil
 	}
@@ -214,12 +361,14 @@ func checkExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 }
 
 func checkUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*binocs.Client)
+	rc := meta.(*retryingClient)
 	payload, err := constructCheckPayload(d)
 	if err != nil {
 		return err
 	}
-	err = client.Checks.Update(d.Id(), payload)
+	err = rc.Do(func() error {
+		return rc.raw.Checks.Update(d.Id(), payload)
+	})
 	if err != nil {
 		return fmt.Errorf("unable to update Binocs check: %s", err)
 	}
@@ -227,8 +376,10 @@ func checkUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func checkDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*binocs.Client)
-	err := client.Checks.Delete(d.Id())
+	rc := meta.(*retryingClient)
+	err := rc.Do(func() error {
+		return rc.raw.Checks.Delete(d.Id())
+	})
 	if err != nil {
 		return fmt.Errorf("unable to remove Binocs check: %s", err)
 	}
@@ -296,9 +447,80 @@ func constructCheckPayload(d *schema.ResourceData) (binocs.Check, error) {
 		payload.DownConfirmationsThreshold = v.(int)
 	}
 
+	if payload.Protocol == protocolHTTP || payload.Protocol == protocolHTTPS {
+		if v, ok := d.GetOk("http"); ok {
+			payload.HTTP = expandCheckHTTP(v.([]interface{}))
+		}
+	}
+
 	return payload, nil
 }
 
+func expandCheckHTTP(l []interface{}) *binocs.CheckHTTP {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+	http := &binocs.CheckHTTP{
+		RequestBody:       m["request_body"].(string),
+		FollowRedirects:   m["follow_redirects"].(bool),
+		ExpectedBodyRegex: m["expected_body_regex"].(string),
+	}
+	if v, ok := m["request_headers"].(map[string]interface{}); ok {
+		headers := make(map[string]string, len(v))
+		for k, hv := range v {
+			headers[k] = hv.(string)
+		}
+		http.RequestHeaders = headers
+	}
+	if v, ok := m["basic_auth"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ba := v[0].(map[string]interface{})
+		http.BasicAuth = &binocs.CheckBasicAuth{
+			Username: ba["username"].(string),
+			Password: ba["password"].(string),
+		}
+	}
+	if v, ok := m["tls"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tls := v[0].(map[string]interface{})
+		http.TLS = &binocs.CheckTLS{
+			SkipVerify:          tls["skip_verify"].(bool),
+			MinVersion:          tls["min_version"].(string),
+			ExpectedFingerprint: tls["expected_fingerprint"].(string),
+		}
+	}
+	return http
+}
+
+func flattenCheckHTTP(http *binocs.CheckHTTP) []interface{} {
+	if http == nil {
+		return nil
+	}
+	m := map[string]interface{}{
+		"request_headers":     http.RequestHeaders,
+		"request_body":        http.RequestBody,
+		"follow_redirects":    http.FollowRedirects,
+		"expected_body_regex": http.ExpectedBodyRegex,
+	}
+	if http.BasicAuth != nil {
+		m["basic_auth"] = []interface{}{
+			map[string]interface{}{
+				"username": http.BasicAuth.Username,
+				"password": http.BasicAuth.Password,
+			},
+		}
+	}
+	if http.TLS != nil {
+		m["tls"] = []interface{}{
+			map[string]interface{}{
+				"skip_verify":          http.TLS.SkipVerify,
+				"min_version":          http.TLS.MinVersion,
+				"expected_fingerprint": http.TLS.ExpectedFingerprint,
+			},
+		}
+	}
+	return []interface{}{m}
+}
+
 func isIP(str string) bool {
 	return net.ParseIP(str) != nil
 }
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/automato-io/binocs-client-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var regexpQuietHours = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]-([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+var supportedNotificationRuleStates = []string{"up", "down", "degraded"}
+
+func notificationRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "`binocs_notification_rule` binds a single notification channel to a single check, with optional per-rule filters. It is an alternative to the `checks` argument on `binocs_channel`, intended for setups where many channels target overlapping groups of checks.",
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Create: notificationRuleCreate,
+		Read:   notificationRuleRead,
+		Exists: notificationRuleExists,
+		Update: notificationRuleUpdate,
+		Delete: notificationRuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the `binocs_channel` this rule notifies.",
+			},
+			"check_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the `binocs_check` this rule watches.",
+			},
+			"on_states": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: fmt.Sprintf("Only notify when the check transitions into one of these states (one or more of %s). Defaults to all states.", strings.Join(supportedNotificationRuleStates, ", ")),
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(supportedNotificationRuleStates, false),
+				},
+			},
+			"severity_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Only notify for incidents at or above this severity (0-10, 0 = all severities).",
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			"quiet_hours": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A daily quiet period during which notifications are suppressed, as `HH:MM-HH:MM` in UTC, e.g. `22:00-06:00`.",
+				ValidateFunc: validation.StringMatch(
+					regexpQuietHours,
+					"expected quiet_hours to be of the form \"HH:MM-HH:MM\"",
+				),
+			},
+			"min_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Only notify once the check has been in the new state for at least this many seconds.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func notificationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	rc := meta.(*retryingClient)
+	payload, err := constructNotificationRulePayload(d)
+	if err != nil {
+		return err
+	}
+	var rule binocs.NotificationRule
+	err = rc.DoOnce(func() error {
+		var err error
+		rule, err = rc.raw.NotificationRules.Create(payload)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create Binocs notification rule: %s", err)
+	}
+	d.SetId(rule.Ident)
+	return notificationRuleRead(d, meta)
+}
+
+func notificationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	rc := meta.(*retryingClient)
+	var rule binocs.NotificationRule
+	err := rc.Do(func() error {
+		var err error
+		rule, err = rc.raw.NotificationRules.Read(d.Id())
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to read Binocs notification rule: %w", err)
+	}
+	for k, v := range map[string]interface{}{
+		"channel_id":           rule.ChannelID,
+		"check_id":             rule.CheckID,
+		"on_states":            rule.OnStates,
+		"severity_min":         rule.SeverityMin,
+		"quiet_hours":          rule.QuietHours,
+		"min_duration_seconds": rule.MinDurationSeconds,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func notificationRuleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	err := notificationRuleRead(d, meta)
+	if err != nil && errors.Is(err, binocs.ErrNotFound) {
+		d.SetId("")
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func notificationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	rc := meta.(*retryingClient)
+	payload, err := constructNotificationRulePayload(d)
+	if err != nil {
+		return err
+	}
+	err = rc.Do(func() error {
+		return rc.raw.NotificationRules.Update(d.Id(), payload)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update Binocs notification rule: %s", err)
+	}
+	return nil
+}
+
+func notificationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	rc := meta.(*retryingClient)
+	err := rc.Do(func() error {
+		return rc.raw.NotificationRules.Delete(d.Id())
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove Binocs notification rule: %s", err)
+	}
+	return nil
+}
+
+func constructNotificationRulePayload(d *schema.ResourceData) (binocs.NotificationRule, error) {
+	payload := binocs.NotificationRule{}
+
+	if v, ok := d.GetOk("channel_id"); ok {
+		payload.ChannelID = v.(string)
+	}
+
+	if v, ok := d.GetOk("check_id"); ok {
+		payload.CheckID = v.(string)
+	}
+
+	if v, ok := d.GetOk("on_states"); ok {
+		interfaceSlice := v.(*schema.Set).List()
+		var stringSlice []string
+		for s := range interfaceSlice {
+			stringSlice = append(stringSlice, interfaceSlice[s].(string))
+		}
+		payload.OnStates = stringSlice
+	}
+
+	if v, ok := d.GetOk("severity_min"); ok {
+		payload.SeverityMin = v.(int)
+	}
+
+	if v, ok := d.GetOk("quiet_hours"); ok {
+		payload.QuietHours = v.(string)
+	}
+
+	if v, ok := d.GetOk("min_duration_seconds"); ok {
+		payload.MinDurationSeconds = v.(int)
+	}
+
+	return payload, nil
+}
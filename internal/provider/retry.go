@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/automato-io/binocs-client-go"
+)
+
+const (
+	defaultMaxRetries     = 4
+	defaultMinBackoff     = 500 * time.Millisecond
+	defaultMaxBackoff     = 30 * time.Second
+	defaultRequestTimeout = 30 * time.Second
+)
+
+// errRequestTimeout marks a call that was aborted after exceeding
+// retryConfig.RequestTimeout. It is treated as a transient error.
+var errRequestTimeout = errors.New("binocs API request timed out")
+
+// retryConfig controls how retryingClient retries transient failures.
+type retryConfig struct {
+	MaxRetries     int
+	MinBackoff     time.Duration
+	MaxBackoff     time.Duration
+	RequestTimeout time.Duration
+}
+
+// retryingClient wraps a *binocs.Client and retries transient failures
+// (network errors, 5xx responses, and rate limiting) with exponential
+// backoff and jitter, honoring Retry-After on 429 responses. Terminal
+// failures (4xx other than 429) are returned to the caller immediately.
+type retryingClient struct {
+	raw    *binocs.Client
+	config retryConfig
+}
+
+func newRetryingClient(raw *binocs.Client, config retryConfig) *retryingClient {
+	return &retryingClient{raw: raw, config: config}
+}
+
+// Do runs fn under rc.config.RequestTimeout, retrying it according to
+// rc.config whenever it returns a transient error. Only use Do for
+// idempotent calls (reads, updates, deletes) — retrying a call whose effect
+// isn't idempotent (e.g. a create) risks applying it twice if a transient
+// error is returned after the server has already processed the request. Use
+// DoOnce for those instead.
+func (rc *retryingClient) Do(fn func() error) error {
+	var err error
+	backoff := rc.config.MinBackoff
+	for attempt := 0; ; attempt++ {
+		err = rc.callWithTimeout(fn)
+		if err == nil || !isRetryableError(err) || attempt >= rc.config.MaxRetries {
+			return err
+		}
+		wait := backoff
+		if ra, ok := retryAfterDuration(err); ok {
+			wait = ra
+		}
+		time.Sleep(wait + jitter(wait))
+		backoff *= 2
+		if backoff > rc.config.MaxBackoff {
+			backoff = rc.config.MaxBackoff
+		}
+	}
+}
+
+// DoOnce runs fn under rc.config.RequestTimeout exactly once, without
+// retrying. Use this for non-idempotent calls (creates, attach/detach)
+// where retrying a transient error could duplicate the write.
+func (rc *retryingClient) DoOnce(fn func() error) error {
+	return rc.callWithTimeout(fn)
+}
+
+// callWithTimeout runs fn, aborting it with errRequestTimeout if it doesn't
+// return within rc.config.RequestTimeout. fn keeps running in the background
+// after a timeout, since the underlying binocs-client-go calls don't accept
+// a context to cancel.
+func (rc *retryingClient) callWithTimeout(fn func() error) error {
+	if rc.config.RequestTimeout <= 0 {
+		return fn()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rc.config.RequestTimeout)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- fn() }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("%w after %s", errRequestTimeout, rc.config.RequestTimeout)
+	}
+}
+
+// isRetryableError classifies err as transient (network failure, 5xx, 429,
+// or a client-side timeout) as opposed to terminal (4xx other than 429).
+func isRetryableError(err error) bool {
+	if errors.Is(err, errRequestTimeout) {
+		return true
+	}
+	var apiErr *binocs.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == 429 || apiErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// retryAfterDuration extracts the Retry-After duration from a 429 response,
+// if one was sent.
+func retryAfterDuration(err error) (time.Duration, bool) {
+	var apiErr *binocs.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == 429 && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter, true
+	}
+	return 0, false
+}
+
+// jitter returns a random duration in [0, d/2], so concurrent retries don't
+// land on the same schedule.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(d)/2 + 1))
+}